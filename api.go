@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"html/template"
+	"net/http"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/auth"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+)
+
+var modelRegistriesTmpl = template.Must(template.New("modelRegistries").Parse(`
+<!DOCTYPE html>
+<html>
+<head><title>Model Registries</title></head>
+<body>
+	<h1>Model Registries</h1>
+	<ul>
+		{{range .}}
+			<li><strong>{{.Name}}</strong> ({{.State}}) - {{.Description}}</li>
+		{{end}}
+	</ul>
+</body>
+</html>
+`))
+
+func renderModelRegistriesHTML(w http.ResponseWriter, items []modelregistry.ModelRegistry) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := modelRegistriesTmpl.Execute(w, items); err != nil {
+		klog.ErrorS(err, "Template execution error")
+	}
+}
+
+// apiErrorResponse is the structured JSON body returned on API errors.
+type apiErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// prefersHTML reports whether the client is a browser that would rather see
+// an HTML page than raw JSON, based on the Accept header.
+func prefersHTML(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	return strings.Contains(accept, "text/html") && !strings.Contains(accept, "application/json")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v != nil {
+		json.NewEncoder(w).Encode(v)
+	}
+}
+
+func writeAPIError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, apiErrorResponse{Error: message})
+}
+
+func requestToken(r *http.Request) string {
+	if user, ok := auth.FromContext(r.Context()); ok && user != nil {
+		return user.Token
+	}
+	return ""
+}
+
+func requestUser(r *http.Request) string {
+	if user, ok := auth.FromContext(r.Context()); ok && user != nil {
+		return user.Name
+	}
+	return ""
+}
+
+// modelRegistryNameFromPath extracts the {name} segment from a request under
+// /api/v1/modelRegistries/, returning "" if there isn't exactly one segment.
+func modelRegistryNameFromPath(r *http.Request) string {
+	name := strings.TrimPrefix(r.URL.Path, "/api/v1/modelRegistries/")
+	if name == "" || strings.Contains(name, "/") {
+		return ""
+	}
+	return name
+}
+
+// listModelRegistriesHandler handles GET /api/v1/modelRegistries.
+func listModelRegistriesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	resp, err := mrLister.ListModelRegistries(r.Context(), requestUser(r), r.URL.Query().Get("namespace"), requestToken(r))
+	if err != nil {
+		handleModelRegistryError(w, r, err)
+		return
+	}
+
+	if prefersHTML(r) {
+		renderModelRegistriesHTML(w, resp.Items)
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// getModelRegistryHandler handles GET /api/v1/modelRegistries/{name}.
+func getModelRegistryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	name := modelRegistryNameFromPath(r)
+	if name == "" {
+		writeAPIError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	entry, err := mrClient.GetModelRegistry(r.Context(), requestToken(r), name)
+	if err != nil {
+		handleModelRegistryError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
+
+// createModelRegistryHandler handles POST /api/v1/modelRegistries.
+func createModelRegistryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var entry modelregistry.ModelRegistry
+	if err := json.NewDecoder(r.Body).Decode(&entry); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if entry.Name == "" {
+		writeAPIError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	created, err := mrClient.CreateModelRegistry(r.Context(), requestToken(r), &entry)
+	if err != nil {
+		handleModelRegistryError(w, r, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// deleteModelRegistryHandler handles DELETE /api/v1/modelRegistries/{name}.
+func deleteModelRegistryHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	name := modelRegistryNameFromPath(r)
+	if name == "" {
+		writeAPIError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	if err := mrClient.DeleteModelRegistry(r.Context(), requestToken(r), name); err != nil {
+		handleModelRegistryError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleModelRegistryError(w http.ResponseWriter, r *http.Request, err error) {
+	var statusErr *modelregistry.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		klog.ErrorS(err, "Model registry service error",
+			"request_id", requestIDFromContext(r.Context()),
+			"upstream_status", statusErr.StatusCode,
+		)
+		writeAPIError(w, statusErr.StatusCode, "model registry service error")
+		return
+	}
+	klog.ErrorS(err, "Error calling model registry service",
+		"request_id", requestIDFromContext(r.Context()),
+	)
+	writeAPIError(w, http.StatusInternalServerError, "error calling model registry service")
+}
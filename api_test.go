@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+)
+
+func newTestModelRegistryClient(t *testing.T, handler http.HandlerFunc) *modelregistry.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := modelregistry.NewClient(modelregistry.Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("modelregistry.NewClient() error = %v", err)
+	}
+	return client
+}
+
+type stubModelRegistryLister struct {
+	resp *modelregistry.ListModelRegistriesResponse
+	err  error
+}
+
+func (s stubModelRegistryLister) ListModelRegistries(ctx context.Context, user, namespace, token string) (*modelregistry.ListModelRegistriesResponse, error) {
+	return s.resp, s.err
+}
+
+func TestPrefersHTML(t *testing.T) {
+	tests := []struct {
+		name   string
+		accept string
+		want   bool
+	}{
+		{name: "no accept header", accept: "", want: false},
+		{name: "plain json", accept: "application/json", want: false},
+		{name: "plain html", accept: "text/html", want: true},
+		{name: "browser default accept", accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", want: true},
+		{name: "html and json both present", accept: "text/html, application/json", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.accept != "" {
+				r.Header.Set("Accept", tt.accept)
+			}
+			if got := prefersHTML(r); got != tt.want {
+				t.Errorf("prefersHTML() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelRegistryNameFromPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "valid name", path: "/api/v1/modelRegistries/mr1", want: "mr1"},
+		{name: "missing name", path: "/api/v1/modelRegistries/", want: ""},
+		{name: "nested segments", path: "/api/v1/modelRegistries/mr1/extra", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if got := modelRegistryNameFromPath(r); got != tt.want {
+				t.Errorf("modelRegistryNameFromPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListModelRegistriesHandler(t *testing.T) {
+	orig := mrLister
+	defer func() { mrLister = orig }()
+
+	mrLister = stubModelRegistryLister{resp: &modelregistry.ListModelRegistriesResponse{
+		Items: []modelregistry.ModelRegistry{{Name: "mr1"}},
+		Size:  1,
+	}}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/modelRegistries", nil)
+	w := httptest.NewRecorder()
+	listModelRegistriesHandler(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	var resp modelregistry.ListModelRegistriesResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response body: %v", err)
+	}
+	if resp.Size != 1 || len(resp.Items) != 1 || resp.Items[0].Name != "mr1" {
+		t.Errorf("response = %+v, want single mr1 entry", resp)
+	}
+}
+
+func TestListModelRegistriesHandlerPrefersHTML(t *testing.T) {
+	orig := mrLister
+	defer func() { mrLister = orig }()
+
+	mrLister = stubModelRegistryLister{resp: &modelregistry.ListModelRegistriesResponse{
+		Items: []modelregistry.ModelRegistry{{Name: "mr1"}},
+		Size:  1,
+	}}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/modelRegistries", nil)
+	r.Header.Set("Accept", "text/html")
+	w := httptest.NewRecorder()
+	listModelRegistriesHandler(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+		t.Errorf("Content-Type = %q, want text/html", ct)
+	}
+	if !strings.Contains(w.Body.String(), "mr1") {
+		t.Errorf("body = %q, want it to contain mr1", w.Body.String())
+	}
+}
+
+func TestListModelRegistriesHandlerMethodNotAllowed(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/modelRegistries", nil)
+	w := httptest.NewRecorder()
+	listModelRegistriesHandler(w, r)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want 405", w.Code)
+	}
+}
+
+func TestListModelRegistriesHandlerUpstreamError(t *testing.T) {
+	orig := mrLister
+	defer func() { mrLister = orig }()
+
+	mrLister = stubModelRegistryLister{err: &modelregistry.HTTPStatusError{StatusCode: http.StatusBadGateway}}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/modelRegistries", nil)
+	w := httptest.NewRecorder()
+	listModelRegistriesHandler(w, r)
+
+	if w.Code != http.StatusBadGateway {
+		t.Errorf("status = %d, want 502 passed through from HTTPStatusError", w.Code)
+	}
+}
+
+func TestGetModelRegistryHandler(t *testing.T) {
+	orig := mrClient
+	defer func() { mrClient = orig }()
+
+	mrClient = newTestModelRegistryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(modelregistry.ModelRegistry{Name: "mr1"})
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/modelRegistries/mr1", nil)
+	w := httptest.NewRecorder()
+	getModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	var entry modelregistry.ModelRegistry
+	if err := json.NewDecoder(w.Body).Decode(&entry); err != nil {
+		t.Fatalf("decoding response body: %v", err)
+	}
+	if entry.Name != "mr1" {
+		t.Errorf("entry.Name = %q, want mr1", entry.Name)
+	}
+}
+
+func TestGetModelRegistryHandlerMissingName(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/modelRegistries/", nil)
+	w := httptest.NewRecorder()
+	getModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 when name is missing", w.Code)
+	}
+}
+
+func TestGetModelRegistryHandlerNotFound(t *testing.T) {
+	orig := mrClient
+	defer func() { mrClient = orig }()
+
+	mrClient = newTestModelRegistryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/modelRegistries/missing", nil)
+	w := httptest.NewRecorder()
+	getModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404 passed through from HTTPStatusError", w.Code)
+	}
+}
+
+func TestCreateModelRegistryHandler(t *testing.T) {
+	orig := mrClient
+	defer func() { mrClient = orig }()
+
+	mrClient = newTestModelRegistryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body modelregistry.ModelRegistry
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(body)
+	})
+
+	body := strings.NewReader(`{"name":"mr1"}`)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/modelRegistries", body)
+	w := httptest.NewRecorder()
+	createModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201", w.Code)
+	}
+	var created modelregistry.ModelRegistry
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatalf("decoding response body: %v", err)
+	}
+	if created.Name != "mr1" {
+		t.Errorf("created.Name = %q, want mr1", created.Name)
+	}
+}
+
+func TestCreateModelRegistryHandlerMissingName(t *testing.T) {
+	body := strings.NewReader(`{}`)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/modelRegistries", body)
+	w := httptest.NewRecorder()
+	createModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 when name is missing", w.Code)
+	}
+}
+
+func TestCreateModelRegistryHandlerInvalidJSON(t *testing.T) {
+	body := strings.NewReader(`not json`)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/modelRegistries", body)
+	w := httptest.NewRecorder()
+	createModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 for invalid JSON body", w.Code)
+	}
+}
+
+func TestDeleteModelRegistryHandler(t *testing.T) {
+	orig := mrClient
+	defer func() { mrClient = orig }()
+
+	mrClient = newTestModelRegistryClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/modelRegistries/mr1", nil)
+	w := httptest.NewRecorder()
+	deleteModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("status = %d, want 204", w.Code)
+	}
+}
+
+func TestDeleteModelRegistryHandlerMissingName(t *testing.T) {
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/modelRegistries/", nil)
+	w := httptest.NewRecorder()
+	deleteModelRegistryHandler(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400 when name is missing", w.Code)
+	}
+}
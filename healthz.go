@@ -0,0 +1,21 @@
+package main
+
+import "net/http"
+
+// healthzHandler reports whether the process is alive.
+func healthzHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// readyzHandler reports whether the server is ready to serve traffic. The
+// model-registry client and auth chain are constructed synchronously at
+// startup, so readiness tracks liveness for this service.
+func readyzHandler(w http.ResponseWriter, r *http.Request) {
+	if mrClient == nil || authChain == nil {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
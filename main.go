@@ -2,11 +2,22 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"html/template"
 	"io/ioutil"
-	"log"
 	"net/http"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/auth"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/authz"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/cache"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/options"
 )
 
 type ParamsData struct {
@@ -14,21 +25,49 @@ type ParamsData struct {
 	ModelRegistry map[string]interface{}
 }
 
-func handleRequest(w http.ResponseWriter, r *http.Request) {
-	log.Printf("Received %s request for %s", r.Method, r.URL.Path)
+var authChain *auth.AuthenticatorChain
+var mrClient *modelregistry.Client
+var mrLister modelRegistryLister
 
-	log.Println("Incoming Request Headers:")
-	for name, values := range r.Header {
-		for _, value := range values {
-			log.Printf("Header: %s=%s", name, value)
-		}
+// newAuthChain builds the authenticator chain from the configured cookie
+// name, in the same order the demo always tried credentials in.
+func newAuthChain(opts *options.ServerRunOptions) *auth.AuthenticatorChain {
+	return auth.NewAuthenticatorChain(
+		auth.NewCookieAuthenticator(opts.AuthCookieName),
+		auth.NewHeaderAuthenticator("kubeflow-userid", ""),
+		auth.NewBearerTokenAuthenticator("x-forwarded-access-token"),
+	)
+}
+
+// newModelRegistryClient builds the typed model-registry client pointed at
+// the configured model-registry-bff-service.
+func newModelRegistryClient(opts *options.ServerRunOptions) *modelregistry.Client {
+	client, err := modelregistry.NewClient(modelregistry.Config{
+		BaseURL: opts.ModelRegistryURL,
+		Timeout: opts.RequestTimeout,
+	})
+	if err != nil {
+		klog.Fatalf("Failed to build model registry client: %v", err)
 	}
+	return client
+}
 
-	log.Println("Incoming Cookies:")
-	for _, cookie := range r.Cookies() {
-		log.Printf("Cookie: %s=%s", cookie.Name, cookie.Value)
+// newAuthorizer builds a SubjectAccessReview-backed authorizer from the
+// in-cluster Kubernetes config. It exits the process on failure since the service is
+// not useful without a working authorization path.
+func newAuthorizer() authz.Authorizer {
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		klog.Fatalf("Failed to load in-cluster config: %v", err)
 	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("Failed to build Kubernetes client: %v", err)
+	}
+	return authz.NewSubjectAccessReviewAuthorizer(client)
+}
 
+func handleRequest(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("X-Frame-Options", "ALLOWALL")
 	w.Header().Set("Content-Security-Policy", "frame-ancestors *;")
 
@@ -42,7 +81,7 @@ func handleRequest(w http.ResponseWriter, r *http.Request) {
 	if r.Method == http.MethodPost {
 		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			log.Printf("Error reading request body: %v", err)
+			klog.ErrorS(err, "Error reading request body", "request_id", requestIDFromContext(r.Context()))
 			http.Error(w, "Unable to read request body", http.StatusBadRequest)
 			return
 		}
@@ -50,7 +89,7 @@ func handleRequest(w http.ResponseWriter, r *http.Request) {
 
 		if len(body) > 0 {
 			if err := json.Unmarshal(body, &params); err != nil {
-				log.Printf("Error parsing JSON: %v", err)
+				klog.ErrorS(err, "Error parsing JSON", "request_id", requestIDFromContext(r.Context()))
 				http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
 				return
 			}
@@ -69,68 +108,39 @@ func handleRequest(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Read the oauth2_proxy_kubeflow cookie
-	oauthCookie, err := r.Cookie("oauth2_proxy_kubeflow")
-	if err != nil {
-		if err == http.ErrNoCookie {
-			log.Println("oauth2_proxy_kubeflow cookie not found")
-			params["oauth2_proxy_kubeflow"] = nil
-		} else {
-			log.Printf("Error reading oauth2_proxy_kubeflow cookie: %v", err)
-			http.Error(w, "Error reading cookies", http.StatusBadRequest)
-			return
-		}
-	} else {
-		params["oauth2_proxy_kubeflow"] = oauthCookie.Value
-	}
-
-	// Read the kubeflow-userid header
-	kubeflowUserID := r.Header.Get("kubeflow-userid")
-	if kubeflowUserID == "" {
-		log.Println("kubeflow-userid header not found")
-	} else {
-		params["kubeflow-userid"] = kubeflowUserID
+	user, ok := auth.FromContext(r.Context())
+	if ok && user != nil {
+		params["user"] = user.Name
+		params["groups"] = user.Groups
+		params["token"] = user.Token
 	}
 
-	// Read the x-forwarded-access-token header
-	xForwardedAccessToken := r.Header.Get("x-forwarded-access-token")
-	if xForwardedAccessToken == "" {
-		log.Println("x-forwarded-access-token header not found")
-	} else {
-		params["x-forwarded-access-token"] = xForwardedAccessToken
+	// Call the model-registry microservice, through the cache/informer lister.
+	var token, userName string
+	if ok && user != nil {
+		token = user.Token
+		userName = user.Name
 	}
+	namespace := r.URL.Query().Get("namespace")
 
-	// Call the model-registry microservice
-	modelRegistryURL := "http://model-registry-bff-service.kubeflow.svc.cluster.local:4000/api/v1/model_registry"
-	modelRegistryResp, err := http.Get(modelRegistryURL)
+	mrResp, err := mrLister.ListModelRegistries(r.Context(), userName, namespace, token)
 	if err != nil {
-		log.Printf("Error calling model registry service: %v", err)
+		if statusErr, ok := err.(*modelregistry.HTTPStatusError); ok {
+			klog.ErrorS(err, "Model registry service error",
+				"request_id", requestIDFromContext(r.Context()),
+				"upstream_status", statusErr.StatusCode,
+			)
+			http.Error(w, "Model registry service error", statusErr.StatusCode)
+			return
+		}
+		klog.ErrorS(err, "Error calling model registry service", "request_id", requestIDFromContext(r.Context()))
 		http.Error(w, "Error calling model registry service", http.StatusInternalServerError)
 		return
 	}
-	defer modelRegistryResp.Body.Close()
 
-	// Print the status code of the model-registry response
-	fmt.Println(modelRegistryResp.StatusCode)
-
-	if modelRegistryResp.StatusCode != http.StatusOK {
-		log.Printf("Model registry service returned status: %s", modelRegistryResp.Status)
-		http.Error(w, "Model registry service error", modelRegistryResp.StatusCode)
-		return
-	}
-
-	modelRegistryBody, err := ioutil.ReadAll(modelRegistryResp.Body)
-	if err != nil {
-		log.Printf("Error reading model registry response body: %v", err)
-		http.Error(w, "Error reading model registry response", http.StatusInternalServerError)
-		return
-	}
-
-	var modelRegistryData map[string]interface{}
-	if err := json.Unmarshal(modelRegistryBody, &modelRegistryData); err != nil {
-		log.Printf("Error parsing model registry JSON: %v", err)
-		http.Error(w, "Invalid JSON from model registry service", http.StatusBadRequest)
-		return
+	modelRegistryData := map[string]interface{}{"size": mrResp.Size}
+	for _, item := range mrResp.Items {
+		modelRegistryData[item.Name] = fmt.Sprintf("%s (%s)", item.DisplayName, item.State)
 	}
 
 	tmpl := `
@@ -167,7 +177,7 @@ func handleRequest(w http.ResponseWriter, r *http.Request) {
 
 	t, err := template.New("index").Parse(tmpl)
 	if err != nil {
-		log.Printf("Template parsing error: %v", err)
+		klog.ErrorS(err, "Template parsing error", "request_id", requestIDFromContext(r.Context()))
 		http.Error(w, "Error parsing template", http.StatusInternalServerError)
 		return
 	}
@@ -179,20 +189,80 @@ func handleRequest(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Type", "text/html; charset=utf-8")
 	if err := t.Execute(w, data); err != nil {
-		log.Printf("Template execution error: %v", err)
+		klog.ErrorS(err, "Template execution error", "request_id", requestIDFromContext(r.Context()))
 		http.Error(w, "Error executing template", http.StatusInternalServerError)
 	}
 }
 
 func main() {
+	opts := options.NewServerRunOptions()
+
+	klog.InitFlags(nil)
+	opts.AddFlags(pflag.CommandLine)
+	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+	pflag.Parse()
+	flag.Set("v", fmt.Sprintf("%d", opts.LogLevel))
+	defer klog.Flush()
+
+	authChain = newAuthChain(opts)
+	mrClient = newModelRegistryClient(opts)
+	authorizer := newAuthorizer()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	mrLister = newModelRegistryLister(opts, mrClient, stopCh)
+
+	authenticated := func(verb string, h http.HandlerFunc) http.Handler {
+		return loggingMiddleware(auth.Middleware(authChain, captureAuthenticatedUser(authz.Middleware(authorizer, verb, h))))
+	}
+
 	mux := http.NewServeMux()
 
-	mux.HandleFunc("/", handleRequest)
-	mux.HandleFunc("/modelRegistry/", handleRequest)
+	mux.Handle("/", authenticated("get", handleRequest))
+	mux.Handle("/modelRegistry/", authenticated("get", handleRequest))
+	mux.HandleFunc("/auth/tokenreview", auth.TokenReviewHandler(authChain))
+
+	// The collection and member endpoints multiplex by method themselves
+	// (rather than registering one pattern per method) so routing keeps
+	// working on Go versions before net/http gained method-prefixed patterns.
+	mux.HandleFunc("/api/v1/modelRegistries", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			authenticated("list", listModelRegistriesHandler).ServeHTTP(w, r)
+		case http.MethodPost:
+			authenticated("create", createModelRegistryHandler).ServeHTTP(w, r)
+		default:
+			writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})
+	mux.HandleFunc("/api/v1/modelRegistries/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			authenticated("get", getModelRegistryHandler).ServeHTTP(w, r)
+		case http.MethodDelete:
+			authenticated("delete", deleteModelRegistryHandler).ServeHTTP(w, r)
+		default:
+			writeAPIError(w, http.StatusMethodNotAllowed, "method not allowed")
+		}
+	})
 
-	port := ":8887"
-	log.Printf("Server is running on port %s", port)
-	if err := http.ListenAndServe(port, mux); err != nil {
-		log.Fatalf("Server failed to start: %v", err)
+	mux.HandleFunc("/healthz", healthzHandler)
+	mux.HandleFunc("/readyz", readyzHandler)
+	mux.Handle("/metrics", cache.Handler())
+
+	server := &http.Server{
+		Addr:    opts.BindAddress,
+		Handler: mux,
+	}
+
+	klog.Infof("Server is running on %s (tls=%t)", opts.BindAddress, opts.TLSEnabled())
+	var err error
+	if opts.TLSEnabled() {
+		err = server.ListenAndServeTLS(opts.TLSCertFile, opts.TLSKeyFile)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		klog.Fatalf("Server failed to start: %v", err)
 	}
 }
@@ -0,0 +1,34 @@
+// Package auth resolves the identity of an incoming request by trying a
+// chain of independent authenticators, merging what each one contributes.
+package auth
+
+import "net/http"
+
+// UserInfo describes the identity resolved from an incoming request.
+type UserInfo struct {
+	Name   string
+	Groups []string
+	Token  string
+	Extra  map[string][]string
+}
+
+// GetName returns the resolved user name, satisfying authz.Subject.
+func (u *UserInfo) GetName() string { return u.Name }
+
+// GetGroups returns the resolved group memberships, satisfying
+// authz.Subject.
+func (u *UserInfo) GetGroups() []string { return u.Groups }
+
+// GetExtra returns any additional identity attributes, satisfying
+// authz.Subject.
+func (u *UserInfo) GetExtra() map[string][]string { return u.Extra }
+
+// Authenticator attempts to resolve a UserInfo from an HTTP request. The
+// second return value reports whether the authenticator recognized
+// credentials at all; callers should keep trying other authenticators when
+// it is false. AuthenticatorChain also keeps trying the remaining
+// authenticators when err is non-nil, whatever ok says, and reports the last
+// error only when no authenticator succeeds.
+type Authenticator interface {
+	AuthenticateRequest(r *http.Request) (user *UserInfo, ok bool, err error)
+}
@@ -0,0 +1,30 @@
+package auth
+
+import "net/http"
+
+// BearerTokenAuthenticator resolves identity from an access token forwarded
+// by an upstream proxy, e.g. the "x-forwarded-access-token" header set by
+// oauth2-proxy.
+type BearerTokenAuthenticator struct {
+	// TokenHeader is the header carrying the bearer token, e.g.
+	// "x-forwarded-access-token".
+	TokenHeader string
+}
+
+// NewBearerTokenAuthenticator returns a BearerTokenAuthenticator for the
+// given header.
+func NewBearerTokenAuthenticator(tokenHeader string) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{TokenHeader: tokenHeader}
+}
+
+// AuthenticateRequest implements Authenticator.
+func (a *BearerTokenAuthenticator) AuthenticateRequest(r *http.Request) (*UserInfo, bool, error) {
+	token := r.Header.Get(a.TokenHeader)
+	if token == "" {
+		return nil, false, nil
+	}
+	return &UserInfo{
+		Token: token,
+		Extra: map[string][]string{"auth-method": {"bearer-token-header"}},
+	}, true, nil
+}
@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// AuthenticatorChain tries a sequence of Authenticators in order and merges
+// their results into a single identity, so a credential authenticator (e.g.
+// the session cookie) and an identity authenticator (e.g. the userid header)
+// can each contribute part of the resolved UserInfo even though only one of
+// them carries the user's name.
+type AuthenticatorChain struct {
+	authenticators []Authenticator
+}
+
+// NewAuthenticatorChain builds a chain from the given authenticators, tried
+// in the order they are passed.
+func NewAuthenticatorChain(authenticators ...Authenticator) *AuthenticatorChain {
+	return &AuthenticatorChain{authenticators: authenticators}
+}
+
+// AuthenticateRequest implements Authenticator by delegating to every
+// authenticator in the chain and merging the UserInfo from each one that
+// recognizes credentials, rather than stopping at the first match. Earlier
+// authenticators win ties for Name, Token and Groups; Extra values from every
+// authenticator are combined.
+func (c *AuthenticatorChain) AuthenticateRequest(r *http.Request) (*UserInfo, bool, error) {
+	var merged *UserInfo
+	var lastErr error
+	for _, a := range c.authenticators {
+		user, ok, err := a.AuthenticateRequest(r)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if !ok {
+			continue
+		}
+		merged = mergeUserInfo(merged, user)
+	}
+	if merged != nil {
+		return merged, true, nil
+	}
+	if lastErr != nil {
+		return nil, false, fmt.Errorf("no authenticator succeeded, last error: %w", lastErr)
+	}
+	return nil, false, nil
+}
+
+// mergeUserInfo folds user into base, filling in Name, Token and Groups only
+// where base doesn't already have them, and appending user's Extra values to
+// base's. base is nil on the first successful authenticator.
+func mergeUserInfo(base, user *UserInfo) *UserInfo {
+	if base == nil {
+		merged := *user
+		return &merged
+	}
+	if base.Name == "" {
+		base.Name = user.Name
+	}
+	if base.Token == "" {
+		base.Token = user.Token
+	}
+	if len(base.Groups) == 0 {
+		base.Groups = user.Groups
+	}
+	for k, v := range user.Extra {
+		if base.Extra == nil {
+			base.Extra = map[string][]string{}
+		}
+		base.Extra[k] = append(base.Extra[k], v...)
+	}
+	return base
+}
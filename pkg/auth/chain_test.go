@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type stubAuthenticator struct {
+	user *UserInfo
+	ok   bool
+	err  error
+}
+
+func (s stubAuthenticator) AuthenticateRequest(r *http.Request) (*UserInfo, bool, error) {
+	return s.user, s.ok, s.err
+}
+
+func TestAuthenticatorChainMergesAcrossAuthenticators(t *testing.T) {
+	chain := NewAuthenticatorChain(
+		stubAuthenticator{ok: true, user: &UserInfo{
+			Token: "cookie-token",
+			Extra: map[string][]string{"auth-method": {"oauth2-proxy-cookie"}},
+		}},
+		stubAuthenticator{ok: true, user: &UserInfo{
+			Name:   "alice",
+			Groups: []string{"admins"},
+			Extra:  map[string][]string{"auth-method": {"kubeflow-userid-header"}},
+		}},
+	)
+
+	user, ok, err := chain.AuthenticateRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("AuthenticateRequest() error = %v", err)
+	}
+	if !ok {
+		t.Fatal("AuthenticateRequest() ok = false, want true")
+	}
+	if user.Name != "alice" {
+		t.Errorf("user.Name = %q, want alice (from the header authenticator)", user.Name)
+	}
+	if user.Token != "cookie-token" {
+		t.Errorf("user.Token = %q, want cookie-token (from the cookie authenticator)", user.Token)
+	}
+	if !reflect.DeepEqual(user.Groups, []string{"admins"}) {
+		t.Errorf("user.Groups = %v, want [admins]", user.Groups)
+	}
+	wantMethods := []string{"oauth2-proxy-cookie", "kubeflow-userid-header"}
+	if !reflect.DeepEqual(user.Extra["auth-method"], wantMethods) {
+		t.Errorf("user.Extra[auth-method] = %v, want %v", user.Extra["auth-method"], wantMethods)
+	}
+}
+
+func TestAuthenticatorChainEarlierAuthenticatorWinsTies(t *testing.T) {
+	chain := NewAuthenticatorChain(
+		stubAuthenticator{ok: true, user: &UserInfo{Name: "first", Token: "first-token"}},
+		stubAuthenticator{ok: true, user: &UserInfo{Name: "second", Token: "second-token"}},
+	)
+
+	user, ok, err := chain.AuthenticateRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("AuthenticateRequest() error = %v", err)
+	}
+	if !ok {
+		t.Fatal("AuthenticateRequest() ok = false, want true")
+	}
+	if user.Name != "first" || user.Token != "first-token" {
+		t.Errorf("user = %+v, want the first authenticator's fields to win", user)
+	}
+}
+
+func TestAuthenticatorChainNoAuthenticatorSucceeds(t *testing.T) {
+	chain := NewAuthenticatorChain(
+		stubAuthenticator{ok: false},
+		stubAuthenticator{ok: false},
+	)
+
+	user, ok, err := chain.AuthenticateRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("AuthenticateRequest() error = %v, want nil", err)
+	}
+	if ok {
+		t.Fatal("AuthenticateRequest() ok = true, want false")
+	}
+	if user != nil {
+		t.Errorf("user = %+v, want nil", user)
+	}
+}
+
+func TestAuthenticatorChainOneErrorsOneSucceeds(t *testing.T) {
+	wantErr := errors.New("boom")
+	chain := NewAuthenticatorChain(
+		stubAuthenticator{err: wantErr},
+		stubAuthenticator{ok: true, user: &UserInfo{Name: "alice"}},
+	)
+
+	user, ok, err := chain.AuthenticateRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("AuthenticateRequest() error = %v, want nil since a later authenticator succeeded", err)
+	}
+	if !ok || user == nil || user.Name != "alice" {
+		t.Errorf("AuthenticateRequest() = %+v, %v, want alice, true", user, ok)
+	}
+}
+
+func TestAuthenticatorChainAllAuthenticatorsError(t *testing.T) {
+	wantErr := errors.New("boom")
+	chain := NewAuthenticatorChain(stubAuthenticator{err: wantErr})
+
+	user, ok, err := chain.AuthenticateRequest(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err == nil {
+		t.Fatal("AuthenticateRequest() error = nil, want non-nil when every authenticator errors")
+	}
+	if !errors.Is(err, wantErr) {
+		t.Errorf("AuthenticateRequest() error = %v, want it to wrap %v", err, wantErr)
+	}
+	if ok || user != nil {
+		t.Errorf("AuthenticateRequest() = %+v, %v, want nil, false", user, ok)
+	}
+}
+
+func TestCookieAuthenticator(t *testing.T) {
+	a := NewCookieAuthenticator("oauth2_proxy_kubeflow")
+
+	t.Run("no cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		_, ok, err := a.AuthenticateRequest(r)
+		if err != nil || ok {
+			t.Errorf("AuthenticateRequest() = %v, %v, want false, nil", ok, err)
+		}
+	})
+
+	t.Run("empty cookie value", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.AddCookie(&http.Cookie{Name: "oauth2_proxy_kubeflow", Value: ""})
+		_, ok, err := a.AuthenticateRequest(r)
+		if err != nil || ok {
+			t.Errorf("AuthenticateRequest() = %v, %v, want false, nil", ok, err)
+		}
+	})
+
+	t.Run("valid cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.AddCookie(&http.Cookie{Name: "oauth2_proxy_kubeflow", Value: "session-value"})
+		user, ok, err := a.AuthenticateRequest(r)
+		if err != nil || !ok {
+			t.Fatalf("AuthenticateRequest() = %v, %v, want true, nil", ok, err)
+		}
+		if user.Token != "session-value" {
+			t.Errorf("user.Token = %q, want session-value", user.Token)
+		}
+		if user.Name != "" {
+			t.Errorf("user.Name = %q, want empty", user.Name)
+		}
+	})
+}
+
+func TestHeaderAuthenticator(t *testing.T) {
+	a := NewHeaderAuthenticator("kubeflow-userid", "kubeflow-groups")
+
+	t.Run("no header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		_, ok, err := a.AuthenticateRequest(r)
+		if err != nil || ok {
+			t.Errorf("AuthenticateRequest() = %v, %v, want false, nil", ok, err)
+		}
+	})
+
+	t.Run("userid and groups", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("kubeflow-userid", "alice")
+		r.Header.Set("kubeflow-groups", "admins, viewers ,")
+		user, ok, err := a.AuthenticateRequest(r)
+		if err != nil || !ok {
+			t.Fatalf("AuthenticateRequest() = %v, %v, want true, nil", ok, err)
+		}
+		if user.Name != "alice" {
+			t.Errorf("user.Name = %q, want alice", user.Name)
+		}
+		if !reflect.DeepEqual(user.Groups, []string{"admins", "viewers"}) {
+			t.Errorf("user.Groups = %v, want [admins viewers]", user.Groups)
+		}
+	})
+}
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	a := NewBearerTokenAuthenticator("x-forwarded-access-token")
+
+	t.Run("no header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		_, ok, err := a.AuthenticateRequest(r)
+		if err != nil || ok {
+			t.Errorf("AuthenticateRequest() = %v, %v, want false, nil", ok, err)
+		}
+	})
+
+	t.Run("token present", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("x-forwarded-access-token", "abc123")
+		user, ok, err := a.AuthenticateRequest(r)
+		if err != nil || !ok {
+			t.Fatalf("AuthenticateRequest() = %v, %v, want true, nil", ok, err)
+		}
+		if user.Token != "abc123" {
+			t.Errorf("user.Token = %q, want abc123", user.Token)
+		}
+	})
+}
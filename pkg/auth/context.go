@@ -0,0 +1,18 @@
+package auth
+
+import "context"
+
+type contextKey int
+
+const userInfoKey contextKey = 0
+
+// NewContext returns a copy of ctx carrying the resolved UserInfo.
+func NewContext(ctx context.Context, user *UserInfo) context.Context {
+	return context.WithValue(ctx, userInfoKey, user)
+}
+
+// FromContext extracts the UserInfo attached to ctx, if any.
+func FromContext(ctx context.Context) (*UserInfo, bool) {
+	user, ok := ctx.Value(userInfoKey).(*UserInfo)
+	return user, ok
+}
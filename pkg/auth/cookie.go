@@ -0,0 +1,35 @@
+package auth
+
+import "net/http"
+
+// CookieAuthenticator resolves identity from the oauth2-proxy session
+// cookie set in front of Kubeflow Central Dashboard.
+type CookieAuthenticator struct {
+	// CookieName is the name of the oauth2-proxy cookie, e.g.
+	// "oauth2_proxy_kubeflow".
+	CookieName string
+}
+
+// NewCookieAuthenticator returns a CookieAuthenticator for the given cookie
+// name.
+func NewCookieAuthenticator(cookieName string) *CookieAuthenticator {
+	return &CookieAuthenticator{CookieName: cookieName}
+}
+
+// AuthenticateRequest implements Authenticator.
+func (a *CookieAuthenticator) AuthenticateRequest(r *http.Request) (*UserInfo, bool, error) {
+	cookie, err := r.Cookie(a.CookieName)
+	if err != nil {
+		if err == http.ErrNoCookie {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if cookie.Value == "" {
+		return nil, false, nil
+	}
+	return &UserInfo{
+		Token: cookie.Value,
+		Extra: map[string][]string{"auth-method": {"oauth2-proxy-cookie"}},
+	}, true, nil
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Middleware authenticates incoming requests using chain and attaches the
+// resolved UserInfo to the request context before calling next. Requests
+// that fail authentication are rejected with 401.
+func Middleware(chain *AuthenticatorChain, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok, err := chain.AuthenticateRequest(r)
+		if err != nil || !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), user)))
+	})
+}
+
+// tokenReviewResponse mirrors the shape of a Kubernetes TokenReview status,
+// scoped to what this service can assert about the caller's identity.
+type tokenReviewResponse struct {
+	Authenticated bool      `json:"authenticated"`
+	User          *UserInfo `json:"user,omitempty"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// TokenReviewHandler authenticates the incoming request using chain and
+// returns the resolved identity as JSON, so other Kubeflow components can
+// validate a token against this service the way the Kubernetes API server's
+// TokenReview endpoint does.
+func TokenReviewHandler(chain *AuthenticatorChain) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		user, ok, err := chain.AuthenticateRequest(r)
+		if err != nil || !ok {
+			w.WriteHeader(http.StatusOK)
+			resp := tokenReviewResponse{Authenticated: false}
+			if err != nil {
+				resp.Error = err.Error()
+			}
+			json.NewEncoder(w).Encode(resp)
+			return
+		}
+
+		json.NewEncoder(w).Encode(tokenReviewResponse{
+			Authenticated: true,
+			User:          user,
+		})
+	}
+}
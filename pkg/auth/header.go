@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+	"strings"
+)
+
+// HeaderAuthenticator resolves identity from the "kubeflow-userid" header
+// that Istio/oauth2-proxy inject once a user is authenticated upstream.
+type HeaderAuthenticator struct {
+	// UserHeader is the header carrying the user id, e.g. "kubeflow-userid".
+	UserHeader string
+	// GroupsHeader optionally carries a comma-separated list of groups.
+	GroupsHeader string
+}
+
+// NewHeaderAuthenticator returns a HeaderAuthenticator for the given headers.
+func NewHeaderAuthenticator(userHeader, groupsHeader string) *HeaderAuthenticator {
+	return &HeaderAuthenticator{UserHeader: userHeader, GroupsHeader: groupsHeader}
+}
+
+// AuthenticateRequest implements Authenticator.
+func (a *HeaderAuthenticator) AuthenticateRequest(r *http.Request) (*UserInfo, bool, error) {
+	userID := r.Header.Get(a.UserHeader)
+	if userID == "" {
+		return nil, false, nil
+	}
+
+	user := &UserInfo{
+		Name:  userID,
+		Extra: map[string][]string{"auth-method": {"kubeflow-userid-header"}},
+	}
+	if a.GroupsHeader != "" {
+		if groups := r.Header.Get(a.GroupsHeader); groups != "" {
+			for _, g := range strings.Split(groups, ",") {
+				if g = strings.TrimSpace(g); g != "" {
+					user.Groups = append(user.Groups, g)
+				}
+			}
+		}
+	}
+	return user, true, nil
+}
@@ -0,0 +1,31 @@
+// Package authz authorizes already-authenticated requests against the
+// Kubernetes RBAC rules of the cluster the service runs in, instead of
+// trusting that an upstream proxy already did so.
+package authz
+
+import "context"
+
+// ResourceAttributes describes the access being checked, mirroring the
+// subset of authorizationv1.ResourceAttributes this service cares about.
+type ResourceAttributes struct {
+	Namespace string
+	Verb      string
+	Resource  string
+	// Name scopes the check to a specific resource instance. Empty means
+	// the check applies to the resource type as a whole.
+	Name string
+}
+
+// Authorizer decides whether a user may perform an action described by
+// ResourceAttributes.
+type Authorizer interface {
+	Authorize(ctx context.Context, user Subject, attrs ResourceAttributes) (allowed bool, reason string, err error)
+}
+
+// Subject is the minimal identity information an Authorizer needs. It is
+// satisfied by *auth.UserInfo.
+type Subject interface {
+	GetName() string
+	GetGroups() []string
+	GetExtra() map[string][]string
+}
@@ -0,0 +1,71 @@
+package authz
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/auth"
+)
+
+// errorResponse is the structured body returned on a denied request.
+type errorResponse struct {
+	Error         string `json:"error"`
+	Reason        string `json:"reason,omitempty"`
+	Verb          string `json:"verb"`
+	Namespace     string `json:"namespace"`
+	ModelRegistry string `json:"modelRegistry,omitempty"`
+}
+
+// Middleware authorizes the user attached to the request context (by
+// auth.Middleware) for verb on the modelregistries resource in the
+// namespace carried by the "namespace" query param, before calling next.
+// If the caller also sets "modelRegistry", the check is scoped to that
+// specific resource instance rather than the resource type as a whole,
+// e.g. "?namespace=...&modelRegistry=...".
+func Middleware(authorizer Authorizer, verb string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := auth.FromContext(r.Context())
+		if !ok || user == nil {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		namespace := r.URL.Query().Get("namespace")
+		modelRegistry := r.URL.Query().Get("modelRegistry")
+
+		allowed, reason, err := authorizer.Authorize(r.Context(), user, ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Resource:  "modelregistries",
+			Name:      modelRegistry,
+		})
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, errorResponse{
+				Error:         "authorization check failed",
+				Reason:        err.Error(),
+				Verb:          verb,
+				Namespace:     namespace,
+				ModelRegistry: modelRegistry,
+			})
+			return
+		}
+		if !allowed {
+			writeJSONError(w, http.StatusForbidden, errorResponse{
+				Error:         "forbidden",
+				Reason:        reason,
+				Verb:          verb,
+				Namespace:     namespace,
+				ModelRegistry: modelRegistry,
+			})
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSONError(w http.ResponseWriter, status int, resp errorResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,66 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// SubjectAccessReviewAuthorizer authorizes requests by issuing a
+// SubjectAccessReview against the Kubernetes API server, the same check
+// "kubectl auth can-i" performs.
+type SubjectAccessReviewAuthorizer struct {
+	client kubernetes.Interface
+}
+
+// NewSubjectAccessReviewAuthorizer returns an Authorizer backed by client.
+func NewSubjectAccessReviewAuthorizer(client kubernetes.Interface) *SubjectAccessReviewAuthorizer {
+	return &SubjectAccessReviewAuthorizer{client: client}
+}
+
+// Authorize implements Authorizer.
+func (a *SubjectAccessReviewAuthorizer) Authorize(ctx context.Context, user Subject, attrs ResourceAttributes) (bool, string, error) {
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.GetName(),
+			Groups: user.GetGroups(),
+			Extra:  toExtra(user.GetExtra()),
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: attrs.Namespace,
+				Verb:      attrs.Verb,
+				Resource:  attrs.Resource,
+				Name:      attrs.Name,
+				Group:     "modelregistry.kubeflow.org",
+			},
+		},
+	}
+
+	result, err := a.client.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, "", fmt.Errorf("subject access review failed: %w", err)
+	}
+
+	if !result.Status.Allowed {
+		reason := result.Status.Reason
+		if reason == "" {
+			reason = "access denied by cluster RBAC"
+		}
+		return false, reason, nil
+	}
+
+	return true, "", nil
+}
+
+func toExtra(extra map[string][]string) map[string]authorizationv1.ExtraValue {
+	if extra == nil {
+		return nil
+	}
+	out := make(map[string]authorizationv1.ExtraValue, len(extra))
+	for k, v := range extra {
+		out[k] = authorizationv1.ExtraValue(v)
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type fakeSubject struct {
+	name   string
+	groups []string
+	extra  map[string][]string
+}
+
+func (s fakeSubject) GetName() string               { return s.name }
+func (s fakeSubject) GetGroups() []string           { return s.groups }
+func (s fakeSubject) GetExtra() map[string][]string { return s.extra }
+
+func TestSubjectAccessReviewAuthorizerAllowed(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.Status.Allowed = sar.Spec.User == "alice"
+		return true, sar, nil
+	})
+
+	authorizer := NewSubjectAccessReviewAuthorizer(client)
+	allowed, _, err := authorizer.Authorize(context.Background(), fakeSubject{name: "alice"}, ResourceAttributes{
+		Namespace: "kubeflow-alice",
+		Verb:      "list",
+		Resource:  "modelregistries",
+	})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if !allowed {
+		t.Error("Authorize() = false, want true for alice")
+	}
+}
+
+func TestSubjectAccessReviewAuthorizerDenied(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.Status.Allowed = false
+		sar.Status.Reason = "no RBAC rule matched"
+		return true, sar, nil
+	})
+
+	authorizer := NewSubjectAccessReviewAuthorizer(client)
+	allowed, reason, err := authorizer.Authorize(context.Background(), fakeSubject{name: "bob"}, ResourceAttributes{
+		Namespace: "kubeflow-alice",
+		Verb:      "list",
+		Resource:  "modelregistries",
+		Name:      "mr1",
+	})
+	if err != nil {
+		t.Fatalf("Authorize() error = %v", err)
+	}
+	if allowed {
+		t.Error("Authorize() = true, want false for bob")
+	}
+	if reason != "no RBAC rule matched" {
+		t.Errorf("reason = %q, want %q", reason, "no RBAC rule matched")
+	}
+}
@@ -0,0 +1,112 @@
+// Package cache sits in front of the model-registry client so that repeated
+// UI refreshes don't each trigger a synchronous upstream call: a short-lived
+// TTL cache serves repeat reads, and singleflight collapses concurrent
+// identical requests into one upstream call.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+)
+
+// Key identifies a cached listing by the requesting user and the namespace
+// they asked about.
+type Key struct {
+	User      string
+	Namespace string
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s/%s", k.Namespace, k.User)
+}
+
+type entry struct {
+	value     *modelregistry.ListModelRegistriesResponse
+	expiresAt time.Time
+}
+
+// TTLCache caches model registry listings per (user, namespace) for a fixed
+// TTL, deduplicating concurrent fetches for the same key.
+type TTLCache struct {
+	ttl     time.Duration
+	metrics *Metrics
+
+	mu      sync.Mutex
+	entries map[Key]entry
+
+	group singleflight.Group
+}
+
+// New returns a TTLCache that caches entries for ttl and records hit/miss
+// and latency metrics against m.
+func New(ttl time.Duration, m *Metrics) *TTLCache {
+	return &TTLCache{
+		ttl:     ttl,
+		metrics: m,
+		entries: make(map[Key]entry),
+	}
+}
+
+// Fetch func type used to populate the cache on a miss.
+type Fetch func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error)
+
+// GetOrFetch returns the cached listing for key if it is still fresh,
+// otherwise calls fetch, storing and returning its result. Concurrent calls
+// for the same key share a single in-flight fetch.
+func (c *TTLCache) GetOrFetch(ctx context.Context, key Key, fetch Fetch) (*modelregistry.ListModelRegistriesResponse, error) {
+	if v, ok := c.get(key); ok {
+		c.metrics.hits.Inc()
+		return v, nil
+	}
+	c.metrics.misses.Inc()
+
+	v, err, _ := c.group.Do(key.String(), func() (interface{}, error) {
+		c.metrics.inFlightRequests.Inc()
+		defer c.metrics.inFlightRequests.Dec()
+
+		// singleflight.Group.Do shares this single call's result with every
+		// concurrent caller for key, so it must not be tied to this caller's
+		// ctx: if ctx were used directly, one caller canceling its own
+		// request (or hitting its own deadline) would fail every other
+		// request sharing the key, even though their contexts are still
+		// live. The model-registry client's own configured timeout bounds
+		// the call instead.
+		start := time.Now()
+		resp, err := fetch(context.Background())
+		c.metrics.upstreamLatency.Observe(time.Since(start).Seconds())
+		if err != nil {
+			return nil, err
+		}
+
+		c.set(key, resp)
+		return resp, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*modelregistry.ListModelRegistriesResponse), nil
+}
+
+func (c *TTLCache) get(key Key) (*modelregistry.ListModelRegistriesResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (c *TTLCache) set(key Key, value *modelregistry.ListModelRegistriesResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = entry{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
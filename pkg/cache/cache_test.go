@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+)
+
+func newTestCache(ttl time.Duration) *TTLCache {
+	return New(ttl, NewMetrics(prometheus.NewRegistry()))
+}
+
+func TestTTLCacheServesFreshEntryWithoutRefetching(t *testing.T) {
+	c := newTestCache(time.Minute)
+	key := Key{User: "alice", Namespace: "kubeflow-alice"}
+
+	var calls int
+	fetch := func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error) {
+		calls++
+		return &modelregistry.ListModelRegistriesResponse{Size: calls}, nil
+	}
+
+	first, err := c.GetOrFetch(context.Background(), key, fetch)
+	if err != nil {
+		t.Fatalf("GetOrFetch() error = %v", err)
+	}
+	second, err := c.GetOrFetch(context.Background(), key, fetch)
+	if err != nil {
+		t.Fatalf("GetOrFetch() error = %v", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1", calls)
+	}
+	if first != second {
+		t.Error("second GetOrFetch() returned a different value than the first, want the cached one")
+	}
+}
+
+func TestTTLCacheRefetchesAfterExpiry(t *testing.T) {
+	c := newTestCache(time.Millisecond)
+	key := Key{User: "alice", Namespace: "kubeflow-alice"}
+
+	var calls int
+	fetch := func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error) {
+		calls++
+		return &modelregistry.ListModelRegistriesResponse{Size: calls}, nil
+	}
+
+	if _, err := c.GetOrFetch(context.Background(), key, fetch); err != nil {
+		t.Fatalf("GetOrFetch() error = %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := c.GetOrFetch(context.Background(), key, fetch); err != nil {
+		t.Fatalf("GetOrFetch() error = %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2 after the entry expired", calls)
+	}
+}
+
+func TestTTLCacheDeduplicatesConcurrentMisses(t *testing.T) {
+	c := newTestCache(time.Minute)
+	key := Key{User: "alice", Namespace: "kubeflow-alice"}
+
+	var calls int
+	var mu sync.Mutex
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		<-release
+		return &modelregistry.ListModelRegistriesResponse{Size: 1}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetOrFetch(context.Background(), key, fetch); err != nil {
+				t.Errorf("GetOrFetch() error = %v", err)
+			}
+		}()
+	}
+
+	close(release)
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1 for concurrent requests sharing a key", calls)
+	}
+}
+
+func TestTTLCacheLeaderCancellationDoesNotFailOtherCallers(t *testing.T) {
+	c := newTestCache(time.Minute)
+	key := Key{User: "alice", Namespace: "kubeflow-alice"}
+
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error) {
+		close(entered)
+		<-release
+		// A real upstream call made with a canceled ctx fails with
+		// ctx.Err(); simulate that here rather than ignoring ctx.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return &modelregistry.ListModelRegistriesResponse{Size: 1}, nil
+	}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// The leader's own request is canceled while its fetch is in
+		// flight. Since GetOrFetch must not pass the leader's ctx to
+		// fetch, this cancellation should not surface as an error here
+		// either, and must not leak into the follower below.
+		if _, err := c.GetOrFetch(leaderCtx, key, fetch); err != nil {
+			t.Errorf("leader GetOrFetch() error = %v, want nil since fetch is detached from the leader's ctx", err)
+		}
+	}()
+
+	<-entered
+	cancelLeader()
+
+	followerErrCh := make(chan error, 1)
+	go func() {
+		_, err := c.GetOrFetch(context.Background(), key, fetch)
+		followerErrCh <- err
+	}()
+
+	close(release)
+	wg.Wait()
+
+	if err := <-followerErrCh; err != nil {
+		t.Errorf("follower GetOrFetch() error = %v, want nil even though the leader's context was canceled", err)
+	}
+}
+
+func TestTTLCacheIsolatesDistinctKeys(t *testing.T) {
+	c := newTestCache(time.Minute)
+
+	var calls int
+	fetch := func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error) {
+		calls++
+		return &modelregistry.ListModelRegistriesResponse{Size: calls}, nil
+	}
+
+	if _, err := c.GetOrFetch(context.Background(), Key{User: "alice", Namespace: "ns-a"}, fetch); err != nil {
+		t.Fatalf("GetOrFetch() error = %v", err)
+	}
+	if _, err := c.GetOrFetch(context.Background(), Key{User: "alice", Namespace: "ns-b"}, fetch); err != nil {
+		t.Fatalf("GetOrFetch() error = %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("fetch called %d times, want 2 for two distinct namespaces", calls)
+	}
+}
@@ -0,0 +1,139 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+)
+
+// modelRegistryGVR is the GroupVersionResource for the ModelRegistry CRD
+// watched by InformerStore.
+var modelRegistryGVR = schema.GroupVersionResource{
+	Group:    "modelregistry.kubeflow.org",
+	Version:  "v1alpha1",
+	Resource: "modelregistries",
+}
+
+// InformerStore keeps an in-memory, eventually-consistent copy of the
+// cluster's ModelRegistry custom resources, refreshed by a
+// SharedInformerFactory watch instead of a synchronous call per request.
+// Entries are keyed by namespace and then name, since the watch is
+// cluster-wide and two namespaces may contain a ModelRegistry with the same
+// name.
+type InformerStore struct {
+	mu      sync.RWMutex
+	entries map[string]map[string]modelregistry.ModelRegistry
+
+	informer cache.SharedIndexInformer
+}
+
+// NewInformerStore builds an InformerStore backed by a dynamic informer for
+// the ModelRegistry CRD, using resyncPeriod as the informer's full resync
+// interval.
+func NewInformerStore(client dynamic.Interface, resyncPeriod time.Duration) *InformerStore {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(client, resyncPeriod)
+	informer := factory.ForResource(modelRegistryGVR).Informer()
+
+	s := &InformerStore{
+		entries:  make(map[string]map[string]modelregistry.ModelRegistry),
+		informer: informer,
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    s.handleUpsert,
+		UpdateFunc: func(_, newObj interface{}) { s.handleUpsert(newObj) },
+		DeleteFunc: s.handleDelete,
+	})
+
+	return s
+}
+
+// Start runs the informer's watch loop in the background until stopCh is
+// closed, and blocks only until the informer's cache has synced (or stopCh
+// is closed first).
+func (s *InformerStore) Start(stopCh <-chan struct{}) {
+	go s.informer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, s.informer.HasSynced) {
+		klog.Error("InformerStore: failed to sync ModelRegistry informer cache")
+	}
+}
+
+// List returns the currently known ModelRegistry entries in namespace. An
+// empty namespace returns entries across all namespaces.
+func (s *InformerStore) List(namespace string) []modelregistry.ModelRegistry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if namespace != "" {
+		byName := s.entries[namespace]
+		out := make([]modelregistry.ModelRegistry, 0, len(byName))
+		for _, v := range byName {
+			out = append(out, v)
+		}
+		return out
+	}
+
+	var out []modelregistry.ModelRegistry
+	for _, byName := range s.entries {
+		for _, v := range byName {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (s *InformerStore) handleUpsert(obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	entry := modelregistry.ModelRegistry{
+		Name:        u.GetName(),
+		Namespace:   u.GetNamespace(),
+		DisplayName: stringField(u, "spec", "displayName"),
+		Description: stringField(u, "spec", "description"),
+		State:       stringField(u, "status", "state"),
+	}
+
+	s.mu.Lock()
+	if s.entries[entry.Namespace] == nil {
+		s.entries[entry.Namespace] = make(map[string]modelregistry.ModelRegistry)
+	}
+	s.entries[entry.Namespace][entry.Name] = entry
+	s.mu.Unlock()
+}
+
+func (s *InformerStore) handleDelete(obj interface{}) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			u, ok = tombstone.Obj.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+		} else {
+			return
+		}
+	}
+
+	s.mu.Lock()
+	delete(s.entries[u.GetNamespace()], u.GetName())
+	s.mu.Unlock()
+}
+
+func stringField(u *unstructured.Unstructured, fields ...string) string {
+	v, found, err := unstructured.NestedString(u.Object, fields...)
+	if err != nil || !found {
+		return ""
+	}
+	return v
+}
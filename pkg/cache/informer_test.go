@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newUnstructuredModelRegistry(namespace, name, displayName string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "modelregistry.kubeflow.org/v1alpha1",
+		"kind":       "ModelRegistry",
+		"metadata": map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+		},
+		"spec": map[string]interface{}{
+			"displayName": displayName,
+		},
+	}}
+}
+
+func newTestInformerStore(t *testing.T, objects ...runtime.Object) *InformerStore {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	gvrToListKind := map[schema.GroupVersionResource]string{
+		modelRegistryGVR: "ModelRegistryList",
+	}
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(scheme, gvrToListKind, objects...)
+
+	store := NewInformerStore(client, time.Minute)
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	store.Start(stopCh)
+	return store
+}
+
+func TestInformerStoreListScopesByNamespace(t *testing.T) {
+	store := newTestInformerStore(t,
+		newUnstructuredModelRegistry("team-a", "shared", "Team A Registry"),
+		newUnstructuredModelRegistry("team-b", "shared", "Team B Registry"),
+	)
+
+	teamA := store.List("team-a")
+	if len(teamA) != 1 || teamA[0].DisplayName != "Team A Registry" {
+		t.Errorf("List(\"team-a\") = %+v, want a single Team A Registry entry", teamA)
+	}
+
+	teamB := store.List("team-b")
+	if len(teamB) != 1 || teamB[0].DisplayName != "Team B Registry" {
+		t.Errorf("List(\"team-b\") = %+v, want a single Team B Registry entry", teamB)
+	}
+}
+
+func TestInformerStoreListAllNamespaces(t *testing.T) {
+	store := newTestInformerStore(t,
+		newUnstructuredModelRegistry("team-a", "shared", "Team A Registry"),
+		newUnstructuredModelRegistry("team-b", "shared", "Team B Registry"),
+	)
+
+	all := store.List("")
+	if len(all) != 2 {
+		t.Errorf("List(\"\") returned %d entries, want 2", len(all))
+	}
+}
@@ -0,0 +1,47 @@
+package cache
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus collectors exposed at /metrics, so dashboard
+// reloads can be observed without scraping upstream on every request.
+type Metrics struct {
+	hits             prometheus.Counter
+	misses           prometheus.Counter
+	upstreamLatency  prometheus.Histogram
+	inFlightRequests prometheus.Gauge
+}
+
+// NewMetrics registers the cache's collectors with reg.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	factory := promauto.With(reg)
+	return &Metrics{
+		hits: factory.NewCounter(prometheus.CounterOpts{
+			Name: "model_registry_cache_hits_total",
+			Help: "Number of model registry listing requests served from cache.",
+		}),
+		misses: factory.NewCounter(prometheus.CounterOpts{
+			Name: "model_registry_cache_misses_total",
+			Help: "Number of model registry listing requests that required an upstream call.",
+		}),
+		upstreamLatency: factory.NewHistogram(prometheus.HistogramOpts{
+			Name:    "model_registry_upstream_latency_seconds",
+			Help:    "Latency of upstream calls to the model-registry service.",
+			Buckets: prometheus.DefBuckets,
+		}),
+		inFlightRequests: factory.NewGauge(prometheus.GaugeOpts{
+			Name: "model_registry_upstream_in_flight_requests",
+			Help: "Number of upstream model-registry calls currently in flight.",
+		}),
+	}
+}
+
+// Handler serves the registered metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,154 @@
+// Package modelregistry is a typed client for the model-registry-bff-service
+// API, replacing ad-hoc http.Get calls with configurable timeouts, TLS and
+// retry behavior.
+package modelregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Config configures a Client.
+type Config struct {
+	// BaseURL is the model-registry-bff-service base URL, e.g.
+	// "http://model-registry-bff-service.kubeflow.svc.cluster.local:4000".
+	BaseURL string
+	// Timeout bounds each individual HTTP request. Defaults to 10s.
+	Timeout time.Duration
+	// MaxRetries is how many times to retry a request on network errors or
+	// 5xx responses. Defaults to 3.
+	MaxRetries int
+	// InsecureSkipVerify disables TLS certificate verification. Only
+	// intended for local/dev deployments.
+	InsecureSkipVerify bool
+	// CABundle is an optional PEM-encoded CA bundle used to verify the
+	// model-registry service's certificate.
+	CABundle []byte
+}
+
+// Client is a typed HTTP client for the model-registry-bff-service API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	maxRetries int
+}
+
+// NewClient builds a Client from cfg, applying the repo's defaults for any
+// zero-valued fields.
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("modelregistry: BaseURL is required")
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = 3
+	}
+
+	tlsConfig, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		baseURL: cfg.BaseURL,
+		httpClient: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		maxRetries: cfg.MaxRetries,
+	}, nil
+}
+
+// ListModelRegistries fetches the model registry entries visible to token,
+// scoped to namespace. An empty namespace lists across all namespaces the
+// caller has access to.
+func (c *Client) ListModelRegistries(ctx context.Context, token, namespace string) (*ListModelRegistriesResponse, error) {
+	path := "/api/v1/model_registry"
+	if namespace != "" {
+		path += "?namespace=" + url.QueryEscape(namespace)
+	}
+	var out ListModelRegistriesResponse
+	if err := c.do(ctx, http.MethodGet, path, token, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetModelRegistry fetches a single model registry entry by name.
+func (c *Client) GetModelRegistry(ctx context.Context, token, name string) (*ModelRegistry, error) {
+	var out ModelRegistry
+	if err := c.do(ctx, http.MethodGet, "/api/v1/model_registry/"+name, token, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateModelRegistry registers a new model registry entry.
+func (c *Client) CreateModelRegistry(ctx context.Context, token string, entry *ModelRegistry) (*ModelRegistry, error) {
+	var out ModelRegistry
+	if err := c.do(ctx, http.MethodPost, "/api/v1/model_registry", token, entry, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteModelRegistry removes a model registry entry by name.
+func (c *Client) DeleteModelRegistry(ctx context.Context, token, name string) error {
+	return c.do(ctx, http.MethodDelete, "/api/v1/model_registry/"+name, token, nil, nil)
+}
+
+// do issues an HTTP request against the model-registry service, retrying on
+// network errors and 5xx responses, and decodes a JSON response into out
+// when out is non-nil.
+func (c *Client) do(ctx context.Context, method, path, token string, body, out interface{}) error {
+	var reqBody *bytes.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("modelregistry: encoding request body: %w", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	var req *http.Request
+	var err error
+	if reqBody != nil {
+		req, err = http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	} else {
+		req, err = http.NewRequestWithContext(ctx, method, c.baseURL+path, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("modelregistry: building request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := doWithRetry(ctx, c.httpClient, req, c.maxRetries)
+	if err != nil {
+		return fmt.Errorf("modelregistry: calling model registry service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return &HTTPStatusError{StatusCode: resp.StatusCode}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("modelregistry: decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package modelregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+func TestClientListModelRegistries(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("method = %s, want GET", r.Method)
+		}
+		if r.URL.Path != "/api/v1/model_registry" {
+			t.Errorf("path = %s, want /api/v1/model_registry", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("namespace"); got != "kubeflow-user" {
+			t.Errorf("namespace query = %q, want kubeflow-user", got)
+		}
+		json.NewEncoder(w).Encode(ListModelRegistriesResponse{
+			Items: []ModelRegistry{{Name: "mr1", Namespace: "kubeflow-user"}},
+			Size:  1,
+		})
+	})
+
+	resp, err := client.ListModelRegistries(context.Background(), "token", "kubeflow-user")
+	if err != nil {
+		t.Fatalf("ListModelRegistries() error = %v", err)
+	}
+	if resp.Size != 1 || len(resp.Items) != 1 || resp.Items[0].Name != "mr1" {
+		t.Errorf("ListModelRegistries() = %+v, want single mr1 entry", resp)
+	}
+}
+
+func TestClientListModelRegistriesNoNamespace(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("RawQuery = %q, want empty when namespace is unset", r.URL.RawQuery)
+		}
+		json.NewEncoder(w).Encode(ListModelRegistriesResponse{})
+	})
+
+	if _, err := client.ListModelRegistries(context.Background(), "token", ""); err != nil {
+		t.Fatalf("ListModelRegistries() error = %v", err)
+	}
+}
+
+func TestClientGetModelRegistry(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/model_registry/mr1" {
+			t.Errorf("path = %s, want /api/v1/model_registry/mr1", r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization header = %q, want Bearer token", got)
+		}
+		json.NewEncoder(w).Encode(ModelRegistry{Name: "mr1"})
+	})
+
+	entry, err := client.GetModelRegistry(context.Background(), "token", "mr1")
+	if err != nil {
+		t.Fatalf("GetModelRegistry() error = %v", err)
+	}
+	if entry.Name != "mr1" {
+		t.Errorf("entry.Name = %q, want mr1", entry.Name)
+	}
+}
+
+func TestClientGetModelRegistryNotFound(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.GetModelRegistry(context.Background(), "token", "missing")
+	var statusErr *HTTPStatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("GetModelRegistry() error = %v, want *HTTPStatusError{404}", err)
+	}
+}
+
+func TestClientCreateModelRegistry(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method = %s, want POST", r.Method)
+		}
+		var body ModelRegistry
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+		if body.Name != "mr1" {
+			t.Errorf("request body name = %q, want mr1", body.Name)
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(body)
+	})
+
+	created, err := client.CreateModelRegistry(context.Background(), "token", &ModelRegistry{Name: "mr1"})
+	if err != nil {
+		t.Fatalf("CreateModelRegistry() error = %v", err)
+	}
+	if created.Name != "mr1" {
+		t.Errorf("created.Name = %q, want mr1", created.Name)
+	}
+}
+
+func TestClientDeleteModelRegistry(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("method = %s, want DELETE", r.Method)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := client.DeleteModelRegistry(context.Background(), "token", "mr1"); err != nil {
+		t.Fatalf("DeleteModelRegistry() error = %v", err)
+	}
+}
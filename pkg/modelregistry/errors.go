@@ -0,0 +1,14 @@
+package modelregistry
+
+import "fmt"
+
+// HTTPStatusError is returned when the model-registry service responds with
+// a status other than 200, 201 or 204. 5xx responses are returned only after
+// retries are exhausted; other statuses are returned without retrying.
+type HTTPStatusError struct {
+	StatusCode int
+}
+
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("model registry service returned status %d", e.StatusCode)
+}
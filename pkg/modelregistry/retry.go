@@ -0,0 +1,53 @@
+package modelregistry
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// defaultBackoff is the base delay used for exponential backoff between
+// retries; it doubles on each attempt.
+const defaultBackoff = 200 * time.Millisecond
+
+// doWithRetry executes req, retrying on network errors and 5xx responses up
+// to maxRetries times with exponential backoff. The caller owns closing the
+// returned response body.
+func doWithRetry(ctx context.Context, client *http.Client, req *http.Request, maxRetries int) (*http.Response, error) {
+	var lastErr error
+	backoff := defaultBackoff
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		attemptReq := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := client.Do(attemptReq)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode >= http.StatusInternalServerError {
+			resp.Body.Close()
+			lastErr = &HTTPStatusError{StatusCode: resp.StatusCode}
+			continue
+		}
+		return resp, nil
+	}
+
+	return nil, lastErr
+}
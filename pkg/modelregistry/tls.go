@@ -0,0 +1,29 @@
+package modelregistry
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+)
+
+// buildTLSConfig assembles a *tls.Config from the client Config: honor an
+// explicit CA bundle when provided, otherwise fall back to the system pool,
+// and allow opting into insecure skip-verify for local/dev registries.
+func buildTLSConfig(cfg Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+
+	if len(cfg.CABundle) == 0 {
+		return tlsConfig, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if ok := pool.AppendCertsFromPEM(cfg.CABundle); !ok {
+		return nil, fmt.Errorf("modelregistry: failed to parse CA bundle")
+	}
+	tlsConfig.RootCAs = pool
+
+	return tlsConfig, nil
+}
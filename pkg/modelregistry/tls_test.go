@@ -0,0 +1,23 @@
+package modelregistry
+
+import "testing"
+
+func TestBuildTLSConfigNoCABundle(t *testing.T) {
+	tlsConfig, err := buildTLSConfig(Config{InsecureSkipVerify: true})
+	if err != nil {
+		t.Fatalf("buildTLSConfig() error = %v", err)
+	}
+	if !tlsConfig.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify = false, want true")
+	}
+	if tlsConfig.RootCAs != nil {
+		t.Error("RootCAs is set, want nil when no CABundle is configured")
+	}
+}
+
+func TestBuildTLSConfigInvalidCABundle(t *testing.T) {
+	_, err := buildTLSConfig(Config{CABundle: []byte("not a PEM certificate")})
+	if err == nil {
+		t.Fatal("buildTLSConfig() error = nil, want error for an invalid CA bundle")
+	}
+}
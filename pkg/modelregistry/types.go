@@ -0,0 +1,19 @@
+package modelregistry
+
+// ModelRegistry mirrors an entry returned by the model-registry BFF's
+// /api/v1/model_registry endpoint.
+type ModelRegistry struct {
+	Name          string `json:"name"`
+	Namespace     string `json:"namespace,omitempty"`
+	DisplayName   string `json:"displayName,omitempty"`
+	Description   string `json:"description,omitempty"`
+	ServerAddress string `json:"serverAddress,omitempty"`
+	State         string `json:"state,omitempty"`
+}
+
+// ListModelRegistriesResponse is the payload returned when listing model
+// registry entries.
+type ListModelRegistriesResponse struct {
+	Items []ModelRegistry `json:"items"`
+	Size  int             `json:"size"`
+}
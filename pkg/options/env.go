@@ -0,0 +1,10 @@
+package options
+
+import "os"
+
+func envOrDefault(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		return v
+	}
+	return def
+}
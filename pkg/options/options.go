@@ -0,0 +1,69 @@
+// Package options defines the server's runtime configuration: a flat struct
+// with sane defaults, bindable to a flag set, so the binary can be
+// configured via CLI flags or environment variables instead of constants
+// baked into the code.
+package options
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// ServerRunOptions holds everything needed to start the server.
+type ServerRunOptions struct {
+	// BindAddress is the address the server listens on, e.g. ":8887".
+	BindAddress string
+	// ModelRegistryURL is the base URL of the model-registry-bff-service.
+	ModelRegistryURL string
+	// AuthCookieName is the oauth2-proxy session cookie name.
+	AuthCookieName string
+	// LogLevel controls klog verbosity (0-5, higher is more verbose).
+	LogLevel int
+	// TLSCertFile and TLSKeyFile enable HTTPS when both are set.
+	TLSCertFile string
+	TLSKeyFile  string
+	// RequestTimeout bounds outbound calls to the model-registry service.
+	RequestTimeout time.Duration
+	// CacheTTL is how long a model registry listing is served from cache
+	// before the next request triggers a fresh upstream call.
+	CacheTTL time.Duration
+	// EnableInformer watches ModelRegistry CRs directly via a
+	// SharedInformerFactory and serves listings from that local store
+	// instead of calling the model-registry service per request.
+	EnableInformer bool
+}
+
+// NewServerRunOptions returns a ServerRunOptions populated with the same
+// defaults the hardcoded demo used, so behavior is unchanged until a flag
+// or env var overrides it.
+func NewServerRunOptions() *ServerRunOptions {
+	return &ServerRunOptions{
+		BindAddress:      envOrDefault("SERVER_BIND_ADDRESS", ":8887"),
+		ModelRegistryURL: envOrDefault("MODEL_REGISTRY_URL", "http://model-registry-bff-service.kubeflow.svc.cluster.local:4000"),
+		AuthCookieName:   envOrDefault("AUTH_COOKIE_NAME", "oauth2_proxy_kubeflow"),
+		LogLevel:         2,
+		RequestTimeout:   10 * time.Second,
+		CacheTTL:         30 * time.Second,
+		EnableInformer:   false,
+	}
+}
+
+// AddFlags binds the options to fs, so CLI flags take precedence over the
+// environment-derived defaults set in NewServerRunOptions.
+func (s *ServerRunOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&s.BindAddress, "bind-address", s.BindAddress, "Address to listen on.")
+	fs.StringVar(&s.ModelRegistryURL, "model-registry-url", s.ModelRegistryURL, "Base URL of the model-registry-bff-service.")
+	fs.StringVar(&s.AuthCookieName, "auth-cookie-name", s.AuthCookieName, "Name of the oauth2-proxy session cookie.")
+	fs.IntVar(&s.LogLevel, "log-level", s.LogLevel, "klog verbosity level (0-5).")
+	fs.StringVar(&s.TLSCertFile, "tls-cert-file", s.TLSCertFile, "Path to a TLS certificate file. Requires --tls-private-key-file.")
+	fs.StringVar(&s.TLSKeyFile, "tls-private-key-file", s.TLSKeyFile, "Path to a TLS private key file. Requires --tls-cert-file.")
+	fs.DurationVar(&s.RequestTimeout, "request-timeout", s.RequestTimeout, "Timeout for outbound calls to the model-registry service.")
+	fs.DurationVar(&s.CacheTTL, "cache-ttl", s.CacheTTL, "How long a model registry listing is cached before being refreshed from upstream.")
+	fs.BoolVar(&s.EnableInformer, "enable-informer", s.EnableInformer, "Watch ModelRegistry CRs via a SharedInformerFactory and serve listings from that local store.")
+}
+
+// TLSEnabled reports whether both TLS flags were provided.
+func (s *ServerRunOptions) TLSEnabled() bool {
+	return s.TLSCertFile != "" && s.TLSKeyFile != ""
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	"github.com/ederign/kf-central-db-integration-demo/pkg/cache"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/modelregistry"
+	"github.com/ederign/kf-central-db-integration-demo/pkg/options"
+)
+
+// newModelRegistryLister builds the modelRegistryLister configured by opts:
+// either an informer-backed local store, or a TTL cache in front of client.
+func newModelRegistryLister(opts *options.ServerRunOptions, client *modelregistry.Client, stopCh <-chan struct{}) modelRegistryLister {
+	if opts.EnableInformer {
+		cfg, err := rest.InClusterConfig()
+		if err != nil {
+			klog.Fatalf("Failed to load in-cluster config for informer: %v", err)
+		}
+		dynamicClient, err := dynamic.NewForConfig(cfg)
+		if err != nil {
+			klog.Fatalf("Failed to build dynamic client for informer: %v", err)
+		}
+
+		store := cache.NewInformerStore(dynamicClient, 10*time.Minute)
+		store.Start(stopCh)
+		return newInformerLister(store)
+	}
+
+	metrics := cache.NewMetrics(prometheus.DefaultRegisterer)
+	return newCachingLister(client, cache.New(opts.CacheTTL, metrics))
+}
+
+// modelRegistryLister abstracts where listModelRegistriesHandler and
+// handleRequest read model registry entries from: either the cached client
+// calling out to model-registry-bff-service, or a local informer-backed
+// store.
+type modelRegistryLister interface {
+	ListModelRegistries(ctx context.Context, user, namespace, token string) (*modelregistry.ListModelRegistriesResponse, error)
+}
+
+// cachingLister serves listings from a TTL cache, falling back to client on
+// a miss and deduplicating concurrent misses for the same key.
+type cachingLister struct {
+	client *modelregistry.Client
+	cache  *cache.TTLCache
+}
+
+func newCachingLister(client *modelregistry.Client, ttlCache *cache.TTLCache) *cachingLister {
+	return &cachingLister{client: client, cache: ttlCache}
+}
+
+func (l *cachingLister) ListModelRegistries(ctx context.Context, user, namespace, token string) (*modelregistry.ListModelRegistriesResponse, error) {
+	key := cache.Key{User: user, Namespace: namespace}
+	return l.cache.GetOrFetch(ctx, key, func(ctx context.Context) (*modelregistry.ListModelRegistriesResponse, error) {
+		return l.client.ListModelRegistries(ctx, token, namespace)
+	})
+}
+
+// informerLister serves listings straight from an InformerStore kept in
+// sync by a SharedInformerFactory watch, so requests never block on an
+// upstream call.
+type informerLister struct {
+	store *cache.InformerStore
+}
+
+func newInformerLister(store *cache.InformerStore) *informerLister {
+	return &informerLister{store: store}
+}
+
+func (l *informerLister) ListModelRegistries(ctx context.Context, user, namespace, token string) (*modelregistry.ListModelRegistriesResponse, error) {
+	items := l.store.List(namespace)
+	return &modelregistry.ListModelRegistriesResponse{Items: items, Size: len(items)}, nil
+}